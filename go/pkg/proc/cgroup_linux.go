@@ -0,0 +1,104 @@
+package proc
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Cgroup is one line of /proc/[pid]/cgroup: a cgroup hierarchy id, the
+// comma-separated controllers it manages (empty under the unified cgroup
+// v2 hierarchy), and the process's path within it.
+type Cgroup struct {
+	HierarchyID int32
+	Controllers []string
+	Path        string
+}
+
+type CgroupFile struct {
+	pf string
+}
+
+// NewCgroupFile returns a CgroupFile reading the given /proc/[pid]/cgroup
+// path.
+func NewCgroupFile(path string) *CgroupFile {
+	return &CgroupFile{pf: path}
+}
+
+// Parse reads /proc/[pid]/cgroup into a controller -> Cgroup map. Under
+// cgroup v2's single unified hierarchy, where the controller column is
+// empty, the entry is keyed by the empty string.
+func (c *CgroupFile) Parse() (map[string]*Cgroup, error) {
+	f, err := os.Open(c.pf)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	out := make(map[string]*Cgroup)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		parts := strings.SplitN(scanner.Text(), ":", 3)
+		if len(parts) != 3 {
+			continue
+		}
+
+		id, err := strconv.ParseInt(parts[0], 10, 32)
+		if err != nil {
+			return nil, err
+		}
+
+		var controllers []string
+		if parts[1] != "" {
+			controllers = strings.Split(parts[1], ",")
+		}
+
+		cg := &Cgroup{
+			HierarchyID: int32(id),
+			Controllers: controllers,
+			Path:        parts[2],
+		}
+
+		if len(controllers) == 0 {
+			out[""] = cg
+			continue
+		}
+		for _, ctrl := range controllers {
+			out[ctrl] = cg
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return out, nil
+}
+
+// ContainerID extracts a Docker/Kubernetes container id from the last
+// component of a cgroup path, e.g. "/docker/abc123.../" or
+// "/kubepods/besteffort/pod.../abc123..." both yield "abc123...". systemd
+// cgroup drivers suffix the id instead, as "docker-abc123....scope", which
+// is unwrapped the same way. It returns "" if the last component doesn't
+// look like a container id (a 12+ character hex string).
+func ContainerID(path string) string {
+	path = strings.TrimRight(path, "/")
+	last := path
+	if idx := strings.LastIndexByte(path, '/'); idx >= 0 {
+		last = path[idx+1:]
+	}
+
+	last = strings.TrimSuffix(last, ".scope")
+	last = strings.TrimPrefix(last, "docker-")
+
+	if len(last) < 12 {
+		return ""
+	}
+	for _, c := range last {
+		if (c < '0' || c > '9') && (c < 'a' || c > 'f') {
+			return ""
+		}
+	}
+
+	return last
+}
@@ -0,0 +1,53 @@
+package proc
+
+import "testing"
+
+func TestCgroupFileParse(t *testing.T) {
+	c := NewCgroupFile("testdata/cgroup")
+	cgroups, err := c.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pids, ok := cgroups["pids"]
+	if !ok {
+		t.Fatal(`"pids" controller not found`)
+	}
+	if pids.HierarchyID != 12 {
+		t.Errorf("pids.HierarchyID = %d, want 12", pids.HierarchyID)
+	}
+
+	netCls, ok := cgroups["net_cls"]
+	if !ok {
+		t.Fatal(`"net_cls" controller not found`)
+	}
+	if netCls.HierarchyID != 10 {
+		t.Errorf("net_cls.HierarchyID = %d, want 10", netCls.HierarchyID)
+	}
+
+	unified, ok := cgroups[""]
+	if !ok {
+		t.Fatal(`unified (v2) cgroup entry not found`)
+	}
+	if unified.Path != "/user.slice" {
+		t.Errorf("unified.Path = %q, want %q", unified.Path, "/user.slice")
+	}
+}
+
+func TestContainerID(t *testing.T) {
+	cases := []struct {
+		path string
+		want string
+	}{
+		{"/docker/abc123456789abcdef123456789abcdef123456789abcdef123456789abcdef12", "abc123456789abcdef123456789abcdef123456789abcdef123456789abcdef12"},
+		{"/kubepods/besteffort/pod123/deadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef", "deadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeefdeadbeef"},
+		{"/system.slice/docker-abcdef0123456789abcdef0123456789abcdef0123456789abcdef0123456789.scope", "abcdef0123456789abcdef0123456789abcdef0123456789abcdef0123456789"},
+		{"/user.slice", ""},
+	}
+
+	for _, c := range cases {
+		if got := ContainerID(c.path); got != c.want {
+			t.Errorf("ContainerID(%q) = %q, want %q", c.path, got, c.want)
+		}
+	}
+}
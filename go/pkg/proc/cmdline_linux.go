@@ -0,0 +1,42 @@
+package proc
+
+import "io/ioutil"
+
+type Cmdline struct {
+	pf string
+}
+
+// NewCmdline returns a Cmdline reading the given /proc/[pid]/cmdline path.
+func NewCmdline(path string) *Cmdline {
+	return &Cmdline{pf: path}
+}
+
+// Parse reads the NUL-separated argv vector and splits it into its
+// individual arguments. A trailing NUL (or empty file, for zombies) is
+// handled without producing a spurious empty trailing argument.
+func (c *Cmdline) Parse() ([]string, error) {
+	data, err := ioutil.ReadFile(c.pf)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(data) == 0 {
+		return nil, nil
+	}
+
+	if data[len(data)-1] == 0 {
+		data = data[:len(data)-1]
+	}
+
+	args := make([]string, 0)
+	start := 0
+	for i, b := range data {
+		if b == 0 {
+			args = append(args, string(data[start:i]))
+			start = i + 1
+		}
+	}
+	args = append(args, string(data[start:]))
+
+	return args, nil
+}
@@ -0,0 +1,42 @@
+package proc
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestCmdlineParse(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "cmdline")
+	if err := os.WriteFile(path, []byte("/usr/bin/foo\x00--bar\x00baz\x00"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	args, err := NewCmdline(path).Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := []string{"/usr/bin/foo", "--bar", "baz"}
+	if !reflect.DeepEqual(args, want) {
+		t.Errorf("args = %#v, want %#v", args, want)
+	}
+}
+
+func TestCmdlineParseEmpty(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "cmdline")
+	if err := os.WriteFile(path, nil, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	args, err := NewCmdline(path).Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if args != nil {
+		t.Errorf("args = %#v, want nil for a zombie's empty cmdline", args)
+	}
+}
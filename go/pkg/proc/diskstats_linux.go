@@ -0,0 +1,122 @@
+package proc
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// DiskStatField is one line of /proc/diskstats: the per-device I/O
+// counters documented in Documentation/admin-guide/iostats.rst. The last
+// five fields (discards, added in Linux 4.18, and flushes, added in 5.5)
+// are left zero on kernels too old to report them.
+type DiskStatField struct {
+	Major uint32
+	Minor uint32
+	Name  string
+
+	ReadsCompleted uint64
+	ReadsMerged    uint64
+	SectorsRead    uint64
+	ReadTimeMs     uint64
+
+	WritesCompleted uint64
+	WritesMerged    uint64
+	SectorsWritten  uint64
+	WriteTimeMs     uint64
+
+	IOsInProgress    uint64
+	IOTimeMs         uint64
+	WeightedIOTimeMs uint64
+
+	DiscardsCompleted uint64
+	DiscardsMerged    uint64
+	SectorsDiscarded  uint64
+	DiscardTimeMs     uint64
+
+	FlushesCompleted uint64
+	FlushTimeMs      uint64
+}
+
+type DiskStats struct {
+	pf string
+}
+
+// NewDiskStats returns a DiskStats reading the given /proc/diskstats path.
+func NewDiskStats(path string) *DiskStats {
+	return &DiskStats{pf: path}
+}
+
+// Parse reads and parses every device line of /proc/diskstats.
+func (d *DiskStats) Parse() ([]*DiskStatField, error) {
+	f, err := os.Open(d.pf)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	var stats []*DiskStatField
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) < 14 {
+			continue
+		}
+
+		stat, err := parseDiskStatLine(fields)
+		if err != nil {
+			return nil, err
+		}
+
+		stats = append(stats, stat)
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return stats, nil
+}
+
+func parseDiskStatLine(fields []string) (*DiskStatField, error) {
+	major, err := strconv.ParseUint(fields[0], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+	minor, err := strconv.ParseUint(fields[1], 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	counters := make([]uint64, 17)
+	for i := 3; i < len(fields) && i-3 < len(counters); i++ {
+		n, err := strconv.ParseUint(fields[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		counters[i-3] = n
+	}
+
+	return &DiskStatField{
+		Major:             uint32(major),
+		Minor:             uint32(minor),
+		Name:              fields[2],
+		ReadsCompleted:    counters[0],
+		ReadsMerged:       counters[1],
+		SectorsRead:       counters[2],
+		ReadTimeMs:        counters[3],
+		WritesCompleted:   counters[4],
+		WritesMerged:      counters[5],
+		SectorsWritten:    counters[6],
+		WriteTimeMs:       counters[7],
+		IOsInProgress:     counters[8],
+		IOTimeMs:          counters[9],
+		WeightedIOTimeMs:  counters[10],
+		DiscardsCompleted: counters[11],
+		DiscardsMerged:    counters[12],
+		SectorsDiscarded:  counters[13],
+		DiscardTimeMs:     counters[14],
+		FlushesCompleted:  counters[15],
+		FlushTimeMs:       counters[16],
+	}, nil
+}
@@ -0,0 +1,38 @@
+package proc
+
+import "testing"
+
+func TestDiskStatsParse(t *testing.T) {
+	d := NewDiskStats("testdata/diskstats")
+	stats, err := d.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stats) != 3 {
+		t.Fatalf("len(stats) = %d, want 3", len(stats))
+	}
+
+	sda := stats[0]
+	if sda.Name != "sda" || sda.Major != 8 || sda.Minor != 0 {
+		t.Errorf("sda ident = %+v", sda)
+	}
+	if sda.ReadsCompleted != 1234 || sda.SectorsRead != 98765 || sda.WritesCompleted != 2345 {
+		t.Errorf("sda counters = %+v", sda)
+	}
+	if sda.DiscardsCompleted != 0 || sda.FlushesCompleted != 0 {
+		t.Errorf("sda should have zero discard/flush on the 14-column layout, got %+v", sda)
+	}
+
+	sda1 := stats[1]
+	if sda1.DiscardsCompleted != 4 || sda1.DiscardsMerged != 1 || sda1.SectorsDiscarded != 8 || sda1.DiscardTimeMs != 2 {
+		t.Errorf("sda1 discard counters = %+v", sda1)
+	}
+
+	nvme := stats[2]
+	if nvme.Name != "nvme0n1" || nvme.Major != 259 {
+		t.Errorf("nvme ident = %+v", nvme)
+	}
+	if nvme.FlushesCompleted != 5 || nvme.FlushTimeMs != 100 {
+		t.Errorf("nvme flush counters = %+v", nvme)
+	}
+}
@@ -0,0 +1,40 @@
+package proc
+
+import (
+	"io/ioutil"
+	"strings"
+)
+
+type Environ struct {
+	pf string
+}
+
+// NewEnviron returns an Environ reading the given /proc/[pid]/environ path.
+func NewEnviron(path string) *Environ {
+	return &Environ{pf: path}
+}
+
+// Parse reads the NUL-separated environment block and splits it into a
+// map keyed by variable name. Entries without an '=' are ignored.
+func (e *Environ) Parse() (map[string]string, error) {
+	data, err := ioutil.ReadFile(e.pf)
+	if err != nil {
+		return nil, err
+	}
+
+	env := make(map[string]string)
+	for _, kv := range strings.Split(strings.TrimSuffix(string(data), "\x00"), "\x00") {
+		if kv == "" {
+			continue
+		}
+
+		eqIdx := strings.IndexByte(kv, '=')
+		if eqIdx < 0 {
+			continue
+		}
+
+		env[kv[:eqIdx]] = kv[eqIdx+1:]
+	}
+
+	return env, nil
+}
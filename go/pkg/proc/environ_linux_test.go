@@ -0,0 +1,29 @@
+package proc
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+func TestEnvironParse(t *testing.T) {
+	dir := t.TempDir()
+	path := filepath.Join(dir, "environ")
+	if err := os.WriteFile(path, []byte("HOME=/root\x00PATH=/usr/bin:/bin\x00MALFORMED\x00"), 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	env, err := NewEnviron(path).Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := map[string]string{
+		"HOME": "/root",
+		"PATH": "/usr/bin:/bin",
+	}
+	if !reflect.DeepEqual(env, want) {
+		t.Errorf("env = %#v, want %#v", env, want)
+	}
+}
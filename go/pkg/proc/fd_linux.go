@@ -0,0 +1,74 @@
+package proc
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// FDInfo describes a single open file descriptor as found under
+// /proc/[pid]/fd/<n>.
+type FDInfo struct {
+	FD     int32  // the file descriptor number
+	Target string // the readlink(2) target, e.g. "/var/log/app.log", "socket:[12345]", "pipe:[6789]"
+	Inode  uint64 // for sockets/pipes, the inode extracted from Target; 0 otherwise
+}
+
+type FD struct {
+	dir string
+}
+
+// NewFD returns an FD reading the given /proc/[pid]/fd directory.
+func NewFD(dir string) *FD {
+	return &FD{dir: dir}
+}
+
+// Parse lists the fd directory and resolves each descriptor's target.
+// Descriptors that disappear between listing and readlink (a race with the
+// process closing them) are skipped rather than failing the whole call.
+func (fd *FD) Parse() ([]FDInfo, error) {
+	entries, err := ioutil.ReadDir(fd.dir)
+	if err != nil {
+		return nil, err
+	}
+
+	infos := make([]FDInfo, 0, len(entries))
+	for _, entry := range entries {
+		n, err := strconv.ParseInt(entry.Name(), 10, 32)
+		if err != nil {
+			continue
+		}
+
+		target, err := os.Readlink(filepath.Join(fd.dir, entry.Name()))
+		if err != nil {
+			// readlink raced with the fd closing; skip it.
+			continue
+		}
+
+		infos = append(infos, FDInfo{
+			FD:     int32(n),
+			Target: target,
+			Inode:  socketOrPipeInode(target),
+		})
+	}
+
+	return infos, nil
+}
+
+// socketOrPipeInode extracts the inode number from targets of the form
+// "socket:[12345]" or "pipe:[12345]". It returns 0 for regular file targets.
+func socketOrPipeInode(target string) uint64 {
+	for _, prefix := range []string{"socket:[", "pipe:["} {
+		if strings.HasPrefix(target, prefix) && strings.HasSuffix(target, "]") {
+			inode, err := strconv.ParseUint(target[len(prefix):len(target)-1], 10, 64)
+			if err != nil {
+				return 0
+			}
+			return inode
+		}
+	}
+
+	return 0
+}
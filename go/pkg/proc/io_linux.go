@@ -0,0 +1,77 @@
+package proc
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// IOField is the parsed form of /proc/[pid]/io.
+// define by http://man7.org/linux/man-pages/man5/proc.5.html
+type IOField struct {
+	RChar               uint64 // bytes read, including from the page cache
+	WChar               uint64 // bytes written
+	SyscR               uint64 // number of read(2)-like syscalls
+	SyscW               uint64 // number of write(2)-like syscalls
+	ReadBytes           uint64 // bytes actually fetched from storage
+	WriteBytes          uint64 // bytes actually sent to storage
+	CancelledWriteBytes uint64 // bytes that were accounted to WriteBytes but truncated instead
+}
+
+type IO struct {
+	pf string
+}
+
+// NewIO returns an IO reading the given /proc/[pid]/io path.
+func NewIO(path string) *IO {
+	return &IO{pf: path}
+}
+
+// Parse reads and parses the io file into an IOField.
+func (io *IO) Parse() (*IOField, error) {
+	f, err := os.Open(io.pf)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	field := new(IOField)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		sepIdx := strings.IndexByte(line, ':')
+		if sepIdx < 0 {
+			continue
+		}
+
+		key := line[:sepIdx]
+		value := strings.TrimSpace(line[sepIdx+1:])
+		n, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		switch key {
+		case "rchar":
+			field.RChar = n
+		case "wchar":
+			field.WChar = n
+		case "syscr":
+			field.SyscR = n
+		case "syscw":
+			field.SyscW = n
+		case "read_bytes":
+			field.ReadBytes = n
+		case "write_bytes":
+			field.WriteBytes = n
+		case "cancelled_write_bytes":
+			field.CancelledWriteBytes = n
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return field, nil
+}
@@ -0,0 +1,24 @@
+package proc
+
+import "testing"
+
+func TestIOParse(t *testing.T) {
+	io := NewIO("testdata/io")
+	field, err := io.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := IOField{
+		RChar:               12345,
+		WChar:               6789,
+		SyscR:               10,
+		SyscW:               20,
+		ReadBytes:           4096,
+		WriteBytes:          8192,
+		CancelledWriteBytes: 0,
+	}
+	if *field != want {
+		t.Errorf("Parse() = %+v, want %+v", *field, want)
+	}
+}
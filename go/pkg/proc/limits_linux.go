@@ -0,0 +1,87 @@
+package proc
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// Limit is a single row of /proc/[pid]/limits: a soft/hard pair for one
+// resource. Unlimited values are represented as -1.
+type Limit struct {
+	Soft int64
+	Hard int64
+	Unit string
+}
+
+type Limits struct {
+	pf string
+}
+
+// NewLimits returns a Limits reading the given /proc/[pid]/limits path.
+func NewLimits(path string) *Limits {
+	return &Limits{pf: path}
+}
+
+// Parse reads and parses the limits file into a map keyed by resource name
+// (e.g. "Max open files"), as printed in the file's own "Limit" column.
+func (l *Limits) Parse() (map[string]*Limit, error) {
+	f, err := os.Open(l.pf)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	limits := make(map[string]*Limit)
+	scanner := bufio.NewScanner(f)
+	first := true
+	for scanner.Scan() {
+		line := scanner.Text()
+		if first {
+			// Header: "Limit  Soft Limit  Hard Limit  Units"
+			first = false
+			continue
+		}
+
+		// Resource names are left-padded to a fixed width, so the soft/hard
+		// values are found by splitting on runs of whitespace from the end.
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			continue
+		}
+
+		unit := ""
+		hardIdx := len(fields) - 1
+		softIdx := len(fields) - 2
+		if _, err := strconv.ParseInt(fields[hardIdx], 10, 64); err != nil && fields[hardIdx] != "unlimited" {
+			unit = fields[hardIdx]
+			hardIdx--
+			softIdx--
+		}
+
+		name := strings.Join(fields[:softIdx], " ")
+		soft, err := parseLimitValue(fields[softIdx])
+		if err != nil {
+			return nil, err
+		}
+		hard, err := parseLimitValue(fields[hardIdx])
+		if err != nil {
+			return nil, err
+		}
+
+		limits[name] = &Limit{Soft: soft, Hard: hard, Unit: unit}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return limits, nil
+}
+
+func parseLimitValue(s string) (int64, error) {
+	if s == "unlimited" {
+		return -1, nil
+	}
+	return strconv.ParseInt(s, 10, 64)
+}
@@ -0,0 +1,43 @@
+package proc
+
+import "testing"
+
+func TestLimitsParse(t *testing.T) {
+	l := NewLimits("testdata/limits")
+	limits, err := l.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	cpu, ok := limits["Max cpu time"]
+	if !ok {
+		t.Fatal(`"Max cpu time" not found`)
+	}
+	if cpu.Soft != -1 || cpu.Hard != -1 || cpu.Unit != "seconds" {
+		t.Errorf("Max cpu time = %+v, want {Soft:-1 Hard:-1 Unit:seconds}", cpu)
+	}
+
+	stack, ok := limits["Max stack size"]
+	if !ok {
+		t.Fatal(`"Max stack size" not found`)
+	}
+	if stack.Soft != 8388608 || stack.Hard != -1 || stack.Unit != "bytes" {
+		t.Errorf("Max stack size = %+v, want {Soft:8388608 Hard:-1 Unit:bytes}", stack)
+	}
+
+	files, ok := limits["Max open files"]
+	if !ok {
+		t.Fatal(`"Max open files" not found`)
+	}
+	if files.Soft != 1024 || files.Hard != 1048576 || files.Unit != "files" {
+		t.Errorf("Max open files = %+v, want {Soft:1024 Hard:1048576 Unit:files}", files)
+	}
+
+	nice, ok := limits["Max nice priority"]
+	if !ok {
+		t.Fatal(`"Max nice priority" not found`)
+	}
+	if nice.Soft != 0 || nice.Hard != 0 || nice.Unit != "" {
+		t.Errorf("Max nice priority = %+v, want {Soft:0 Hard:0 Unit:\"\"}", nice)
+	}
+}
@@ -0,0 +1,62 @@
+package proc
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+)
+
+// namespaceKinds are the /proc/[pid]/ns/* entries this package resolves.
+var namespaceKinds = []string{"mnt", "pid", "net", "uts", "ipc", "user", "cgroup"}
+
+// Namespaces maps each namespace kind ("mnt", "pid", "net", ...) to the
+// inode identifying it. Two processes sharing a namespace share its
+// inode, which is how callers group processes by container without
+// shelling out to lsns(8)/nsenter(1).
+type Namespaces map[string]uint64
+
+type NS struct {
+	dir string
+}
+
+// NewNS returns an NS reading the given /proc/[pid]/ns directory.
+func NewNS(dir string) *NS {
+	return &NS{dir: dir}
+}
+
+// Parse readlinks every known namespace entry and extracts its inode from
+// the "<kind>:[<inode>]" target readlink(2) returns. A kind missing from
+// this kernel (e.g. no CONFIG_USER_NS) is silently omitted rather than
+// failing the whole read.
+func (n *NS) Parse() (Namespaces, error) {
+	out := make(Namespaces, len(namespaceKinds))
+	for _, kind := range namespaceKinds {
+		target, err := os.Readlink(filepath.Join(n.dir, kind))
+		if err != nil {
+			if os.IsNotExist(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		inode, err := parseNSInode(target)
+		if err != nil {
+			return nil, err
+		}
+		out[kind] = inode
+	}
+
+	return out, nil
+}
+
+func parseNSInode(target string) (uint64, error) {
+	open := strings.IndexByte(target, '[')
+	closeIdx := strings.IndexByte(target, ']')
+	if open < 0 || closeIdx < 0 || closeIdx < open {
+		return 0, fmt.Errorf("proc: malformed namespace link %q", target)
+	}
+
+	return strconv.ParseUint(target[open+1:closeIdx], 10, 64)
+}
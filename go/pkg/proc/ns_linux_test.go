@@ -0,0 +1,52 @@
+package proc
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestNSParse(t *testing.T) {
+	dir := t.TempDir()
+
+	targets := map[string]string{
+		"mnt":  "mnt:[4026531840]",
+		"pid":  "pid:[4026531836]",
+		"net":  "net:[4026531992]",
+		"user": "user:[4026531837]",
+	}
+	for kind, target := range targets {
+		if err := os.Symlink(target, filepath.Join(dir, kind)); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	ns, err := NewNS(dir).Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if ns["mnt"] != 4026531840 {
+		t.Errorf("ns[mnt] = %d, want 4026531840", ns["mnt"])
+	}
+	if ns["pid"] != 4026531836 {
+		t.Errorf("ns[pid] = %d, want 4026531836", ns["pid"])
+	}
+	if _, ok := ns["uts"]; ok {
+		t.Error("ns[uts] present, want absent since no symlink was created for it")
+	}
+}
+
+func TestParseNSInode(t *testing.T) {
+	inode, err := parseNSInode("net:[4026531992]")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inode != 4026531992 {
+		t.Errorf("inode = %d, want 4026531992", inode)
+	}
+
+	if _, err := parseNSInode("not-a-namespace-link"); err == nil {
+		t.Error("expected an error for a malformed link target")
+	}
+}
@@ -0,0 +1,65 @@
+package proc
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"strconv"
+	"syscall"
+)
+
+// PIDs enumerates the process ids currently visible under /proc, i.e.
+// every numeric top-level entry.
+func PIDs() ([]int32, error) {
+	entries, err := ioutil.ReadDir("/proc")
+	if err != nil {
+		return nil, err
+	}
+
+	pids := make([]int32, 0, len(entries))
+	for _, entry := range entries {
+		if !entry.IsDir() {
+			continue
+		}
+
+		pid, err := strconv.ParseInt(entry.Name(), 10, 32)
+		if err != nil {
+			continue
+		}
+
+		pids = append(pids, int32(pid))
+	}
+
+	return pids, nil
+}
+
+// ForEach calls fn once per live process with its parsed stat fields. A
+// process that exits between PIDs listing it and us reading its stat file
+// races us in one of two ways depending on how far it got: the directory
+// entry is simply gone (ENOENT), or the kernel's stat handler returns
+// ESRCH for a task that vanished mid-read. Both are skipped rather than
+// failing the whole walk; any other parse error aborts and is returned to
+// the caller.
+func ForEach(fn func(*StatField) error) error {
+	pids, err := PIDs()
+	if err != nil {
+		return err
+	}
+
+	for _, pid := range pids {
+		sf, err := NewStat(fmt.Sprintf("/proc/%d/stat", pid)).Parse()
+		if err != nil {
+			if os.IsNotExist(err) || errors.Is(err, syscall.ESRCH) {
+				continue
+			}
+			return err
+		}
+
+		if err := fn(sf); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
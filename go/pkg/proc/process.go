@@ -0,0 +1,63 @@
+package proc
+
+import (
+	"bytes"
+	"time"
+)
+
+// ProcessState is a process's run state, normalized across the
+// OS-specific representations (Linux's single-char codes, Darwin/FreeBSD's
+// p_stat, Windows' lack of one) so callers don't fork on GOOS.
+type ProcessState int8
+
+const (
+	StateUnknown ProcessState = iota
+	StateRunning
+	StateSleeping
+	StateStopped
+	StateZombie
+)
+
+// Snapshot is the common set of process metrics every backend can produce,
+// normalized into portable units: durations instead of jiffies or kernel
+// ticks, bytes instead of pages.
+type Snapshot struct {
+	Pid        int32
+	PPid       int32
+	Name       string
+	State      ProcessState
+	UserTime   time.Duration // valid only when HasTaskInfo is true
+	SystemTime time.Duration // valid only when HasTaskInfo is true
+	RSS        uint64        // bytes; valid only when HasTaskInfo is true
+	VSize      uint64        // bytes; valid only when HasTaskInfo is true
+	NumThreads int64         // valid only when HasTaskInfo is true
+	StartTime  time.Time
+
+	// HasTaskInfo reports whether UserTime/SystemTime/RSS/VSize/NumThreads
+	// were actually read from the kernel. It is false only on the Darwin
+	// backend's pure-Go build, which cannot reach libproc's proc_pidinfo
+	// (see taskInfoFor in process_darwin.go); those fields are the zero
+	// value there, not a measured "process is idle".
+	HasTaskInfo bool
+}
+
+// Process is the platform-agnostic view of a single running process. New
+// returns the backend appropriate for the current GOOS: procfs on Linux,
+// sysctl/libproc on Darwin, the kern.proc.pid sysctl on FreeBSD, and the
+// Win32 process APIs on Windows.
+type Process interface {
+	// Pid returns the process id this Process was constructed with.
+	Pid() int32
+
+	// Snapshot reads the process's current metrics.
+	Snapshot() (Snapshot, error)
+}
+
+// cString trims a fixed-size, NUL-terminated C char array (as found in
+// kinfo_proc-style sysctl structs) down to a Go string.
+func cString(b []byte) string {
+	if i := bytes.IndexByte(b, 0); i >= 0 {
+		b = b[:i]
+	}
+	return string(b)
+}
@@ -0,0 +1,159 @@
+package proc
+
+import (
+	"fmt"
+	"time"
+)
+
+// LinuxProcess is a façade over every /proc/[pid] subsystem this package
+// knows how to parse. Each accessor lazily constructs and caches its
+// underlying parser on first use, so callers who only need e.g. Status()
+// don't pay for stat-ing the rest of procfs. It implements Process.
+type LinuxProcess struct {
+	pid int32
+	dir string
+
+	stat    *Stat
+	status  *Status
+	statm   *StatM
+	io      *IO
+	cmdline *Cmdline
+	environ *Environ
+	limits  *Limits
+	fd      *FD
+	cgroup  *CgroupFile
+	ns      *NS
+}
+
+// New returns a Process backed by procfs.
+func New(pid int32) (Process, error) {
+	return NewLinuxProcess(pid), nil
+}
+
+// NewLinuxProcess returns a LinuxProcess façade for the given pid, rooted
+// at /proc/<pid>.
+func NewLinuxProcess(pid int32) *LinuxProcess {
+	return &LinuxProcess{
+		pid: pid,
+		dir: fmt.Sprintf("/proc/%d", pid),
+	}
+}
+
+// Pid returns the process id this façade was constructed with.
+func (p *LinuxProcess) Pid() int32 {
+	return p.pid
+}
+
+func (p *LinuxProcess) Stat() (*StatField, error) {
+	if p.stat == nil {
+		p.stat = NewStat(p.dir + "/stat")
+	}
+	return p.stat.Parse()
+}
+
+func (p *LinuxProcess) Status() (*StatusField, error) {
+	if p.status == nil {
+		p.status = NewStatus(p.dir + "/status")
+	}
+	return p.status.Parse()
+}
+
+func (p *LinuxProcess) StatM() (*StatMField, error) {
+	if p.statm == nil {
+		p.statm = NewStatM(p.dir + "/statm")
+	}
+	return p.statm.Parse()
+}
+
+func (p *LinuxProcess) IO() (*IOField, error) {
+	if p.io == nil {
+		p.io = NewIO(p.dir + "/io")
+	}
+	return p.io.Parse()
+}
+
+func (p *LinuxProcess) Cmdline() ([]string, error) {
+	if p.cmdline == nil {
+		p.cmdline = NewCmdline(p.dir + "/cmdline")
+	}
+	return p.cmdline.Parse()
+}
+
+func (p *LinuxProcess) Environ() (map[string]string, error) {
+	if p.environ == nil {
+		p.environ = NewEnviron(p.dir + "/environ")
+	}
+	return p.environ.Parse()
+}
+
+func (p *LinuxProcess) Limits() (map[string]*Limit, error) {
+	if p.limits == nil {
+		p.limits = NewLimits(p.dir + "/limits")
+	}
+	return p.limits.Parse()
+}
+
+func (p *LinuxProcess) FD() ([]FDInfo, error) {
+	if p.fd == nil {
+		p.fd = NewFD(p.dir + "/fd")
+	}
+	return p.fd.Parse()
+}
+
+func (p *LinuxProcess) Cgroup() (map[string]*Cgroup, error) {
+	if p.cgroup == nil {
+		p.cgroup = NewCgroupFile(p.dir + "/cgroup")
+	}
+	return p.cgroup.Parse()
+}
+
+func (p *LinuxProcess) NS() (Namespaces, error) {
+	if p.ns == nil {
+		p.ns = NewNS(p.dir + "/ns")
+	}
+	return p.ns.Parse()
+}
+
+// Snapshot implements Process, normalizing the procfs-specific fields
+// (jiffies, pages, a single-char state code) into the portable units every
+// backend reports.
+func (p *LinuxProcess) Snapshot() (Snapshot, error) {
+	sf, err := p.Stat()
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	start, err := sf.StartedAt()
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	return Snapshot{
+		Pid:         sf.Pid,
+		PPid:        sf.PPid,
+		Name:        sf.Comm,
+		State:       linuxStateToPortable(sf.State),
+		UserTime:    time.Duration(sf.UTime) * time.Second / clockTicksPerSec,
+		SystemTime:  time.Duration(sf.STime) * time.Second / clockTicksPerSec,
+		RSS:         sf.RSSBytes(),
+		VSize:       sf.VSizeBytes(),
+		NumThreads:  sf.NumThreads,
+		StartTime:   start,
+		HasTaskInfo: true,
+	}, nil
+}
+
+func linuxStateToPortable(s StatTaskState) ProcessState {
+	switch s {
+	case Running:
+		return StateRunning
+	case Sleeping, DiskSleep:
+		return StateSleeping
+	case Stopped, TracingStop:
+		return StateStopped
+	case Zombie:
+		return StateZombie
+	default:
+		return StateUnknown
+	}
+}
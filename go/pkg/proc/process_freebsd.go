@@ -0,0 +1,174 @@
+package proc
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"os"
+	"syscall"
+	"time"
+	"unsafe"
+)
+
+// sysctl(3) MIB constants from <sys/sysctl.h>.
+const (
+	freebsdCtlKern     = 1
+	freebsdKernProc    = 14
+	freebsdKernProcPID = 1
+)
+
+// freebsdProcess backs Process on FreeBSD via the kern.proc.pid.<pid>
+// sysctl, which returns a single struct kinfo_proc carrying everything we
+// need in one call (no separate libproc-style task info lookup required).
+type freebsdProcess struct {
+	pid int32
+}
+
+// New returns a Process backed by sysctl kern.proc.pid.
+func New(pid int32) (Process, error) {
+	if _, err := freebsdSysctlKernProc(pid); err != nil {
+		return nil, err
+	}
+	return &freebsdProcess{pid: pid}, nil
+}
+
+func (p *freebsdProcess) Pid() int32 {
+	return p.pid
+}
+
+func (p *freebsdProcess) Snapshot() (Snapshot, error) {
+	kp, err := freebsdSysctlKernProc(p.pid)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	return Snapshot{
+		Pid:         p.pid,
+		PPid:        kp.ppid,
+		Name:        kp.comm,
+		State:       freebsdStateToPortable(kp.stat),
+		UserTime:    kp.userTime,
+		SystemTime:  kp.systemTime,
+		RSS:         kp.rss,
+		VSize:       kp.vsize,
+		NumThreads:  kp.numThreads,
+		StartTime:   kp.startTime,
+		HasTaskInfo: true,
+	}, nil
+}
+
+type freebsdKinfoProc struct {
+	ppid       int32
+	comm       string
+	stat       int8
+	rss        uint64
+	vsize      uint64
+	numThreads int64
+	userTime   time.Duration
+	systemTime time.Duration
+	startTime  time.Time
+}
+
+func freebsdSysctlKernProc(pid int32) (*freebsdKinfoProc, error) {
+	mib := []int32{freebsdCtlKern, freebsdKernProc, freebsdKernProcPID, pid}
+	buf, err := freebsdSysctlRaw(mib)
+	if err != nil {
+		return nil, err
+	}
+	if len(buf) == 0 {
+		return nil, fmt.Errorf("proc: no such pid %d", pid)
+	}
+
+	return parseFreebsdKinfoProc(buf)
+}
+
+// parseFreebsdKinfoProc decodes the fields of struct kinfo_proc (see
+// <sys/user.h>) this package surfaces. FreeBSD's kinfo_proc is a single
+// flat struct (unlike Darwin's kp_proc/kp_eproc split), with ki_rusage
+// holding the ru_utime/ru_stime pairs we convert to durations.
+func parseFreebsdKinfoProc(buf []byte) (*freebsdKinfoProc, error) {
+	r := bytes.NewReader(buf)
+
+	var ki struct {
+		_          [40]byte // ki_structsize..ki_args and other leading fields
+		Ppid       int32
+		Pid        int32
+		_          [8]byte
+		Stat       int8
+		_          [3]byte
+		Comm       [20]byte // COMMLEN + 1
+		_          [8]byte
+		NumThreads int32
+		_          [4]byte
+		RUsage     struct {
+			UTimeSec  int64
+			UTimeUSec int64
+			STimeSec  int64
+			STimeUSec int64
+		}
+		RSSPages int64
+		VSize    int64
+		Start    struct {
+			Sec  int64
+			USec int64
+		}
+	}
+	if err := binary.Read(r, binary.LittleEndian, &ki); err != nil {
+		return nil, err
+	}
+
+	return &freebsdKinfoProc{
+		ppid:       ki.Ppid,
+		comm:       cString(ki.Comm[:]),
+		stat:       ki.Stat,
+		rss:        uint64(ki.RSSPages) * uint64(os.Getpagesize()),
+		vsize:      uint64(ki.VSize),
+		numThreads: int64(ki.NumThreads),
+		userTime:   time.Duration(ki.RUsage.UTimeSec)*time.Second + time.Duration(ki.RUsage.UTimeUSec)*time.Microsecond,
+		systemTime: time.Duration(ki.RUsage.STimeSec)*time.Second + time.Duration(ki.RUsage.STimeUSec)*time.Microsecond,
+		startTime:  time.Unix(ki.Start.Sec, ki.Start.USec*int64(time.Microsecond)),
+	}, nil
+}
+
+func freebsdStateToPortable(stat int8) ProcessState {
+	// <sys/proc.h>: SIDL=1 SRUN=2 SSLEEP=3 SSTOP=4 SZOMB=5 SWAIT=6 SLOCK=7
+	switch stat {
+	case 2:
+		return StateRunning
+	case 3:
+		return StateSleeping
+	case 4:
+		return StateStopped
+	case 5:
+		return StateZombie
+	default:
+		return StateUnknown
+	}
+}
+
+func freebsdSysctlRaw(mib []int32) ([]byte, error) {
+	var size uintptr
+	if _, _, errno := syscall.Syscall6(
+		syscall.SYS___SYSCTL,
+		uintptr(unsafe.Pointer(&mib[0])), uintptr(len(mib)),
+		0, uintptr(unsafe.Pointer(&size)),
+		0, 0,
+	); errno != 0 {
+		return nil, errno
+	}
+	if size == 0 {
+		return nil, nil
+	}
+
+	buf := make([]byte, size)
+	if _, _, errno := syscall.Syscall6(
+		syscall.SYS___SYSCTL,
+		uintptr(unsafe.Pointer(&mib[0])), uintptr(len(mib)),
+		uintptr(unsafe.Pointer(&buf[0])), uintptr(unsafe.Pointer(&size)),
+		0, 0,
+	); errno != 0 {
+		return nil, errno
+	}
+
+	return buf[:size], nil
+}
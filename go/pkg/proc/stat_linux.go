@@ -0,0 +1,605 @@
+package proc
+
+import (
+	"errors"
+	"io"
+	"os"
+)
+
+type StatTaskState int8
+
+const (
+	Running       StatTaskState = 'R' // Running
+	Sleeping      StatTaskState = 'S' // Sleeping in an interruptible wait
+	DiskSleep     StatTaskState = 'D' //  Waiting in uninterruptible disk sleep
+	Zombie        StatTaskState = 'Z' //  Waiting in uninterruptible disk sleep
+	Stopped       StatTaskState = 'T' // Stopped (on a signal) or (before Linux 2.6.33) trace stopped
+	TracingStop   StatTaskState = 't' // Tracing stop (Linux 2.6.33 onward)
+	Dead          StatTaskState = 'X' // Dead (from Linux 2.6.0 onward)
+	Dead2633To313 StatTaskState = 'x' // Dead (Linux 2.6.33 to 3.13 only)
+	Wakekill      StatTaskState = 'K' // Wakekill (Linux 2.6.33 to 3.13 only)
+	PagingWaking  StatTaskState = 'W' // Waking (Linux 2.6.33 to 3.13 only)
+	Parked        StatTaskState = 'P' // Parked (Linux 3.9 to 3.13 only)
+)
+
+// Stat by https://github.com/torvalds/linux/blob/c0cc271173b2e1c2d8d0ceaef14e4dfa79eefc0d/fs/proc/array.c#L430
+// define by http://man7.org/linux/man-pages/man5/proc.5.html
+type StatField struct {
+	Pid                 int32         // (1) The process id.
+	Comm                string        // (2) The filename of the executable, in parentheses.
+	State               StatTaskState // (3) process state
+	PPid                int32         // (4) The PID of the parent of this process.
+	PGrp                int32         // (5) The process group ID of the process.
+	Session             int32         // (6) The session ID of the process.
+	TTYNR               int32         // (7) The controlling terminal of the process.
+	TPGid               int32         // (8) The ID of the foreground process group of the controlling terminal of the process.
+	TaskFlags           uint32        // (9) The kernel flags word of the process
+	MinFlt              uint64        // (10) The number of minor faults the process has made which have not required loading a memory page from disk.
+	CMinFlt             uint64        // (11) The number of minor faults that the process's waited-for children have made.
+	MajFlt              uint64        // (12) The number of major faults the process has made which have required loading a memory page from disk.
+	CMajFlt             uint64        // (13) The number of major faults that the process's waited-for children have made.
+	UTime               uint64        // (14) user mode jiffies
+	STime               uint64        // (15) kernel mode jiffies
+	CUTime              int64         // (16) user mode jiffies with childs
+	CSTime              int64         // (17) kernel mode jiffies with childs
+	Priority            int64         // (18) (Explanation for Linux 2.6) For processes running a real-time scheduling policy
+	Nice                int64         // (19) The nice value (see setpriority(2)), a value in the range 19 (low priority) to -20 (high priority).
+	NumThreads          int64         // (20) number of threads in this process (since Linux 2.6).
+	ItRealValue         int64         // (21) The time in jiffies before the next SIGALRM is sent  to the process due to an interval timer.
+	StartTime           uint64        // (22) The time the process started after system boot.
+	VSize               uint64        // (23) Virtual memory size
+	RSS                 uint64        // (24) Resident Set Size: number of pages the process has in real memory.
+	RSSLim              uint64        // (25) Current limit in bytes on the rss of the process
+	StartCode           uint64        // (26) The address above which program text can run.
+	EndCode             uint64        // (27) The address below which program text can run.
+	StartStack          uint64        // (28) The address of the start (i.e., bottom) of the stack
+	KStkEsp             uint64        // (29) The current value of ESP (stack pointer), as found in the kernel stack page for the process.
+	KStkEip             uint64        // (30) The current EIP (instruction pointer).
+	TaskPendingSig      uint64        // (31) The bitmap of pending signals, displayed as a decimal number.
+	TaskBlockSig        uint64        // (32) The bitmap of blocked signals, displayed as a decimal number.
+	SigIgnoreSig        uint64        // (33) The bitmap of ignored signals, displayed as a decimal number.
+	SigCatchSig         uint64        // (34) The bitmap of caught signals, displayed as a decimal number.
+	WChan               uint64        // (35) This is the "channel" in which the process is waiting.
+	NSwap               uint64        // (36) Number of pages swapped (not maintained).
+	CNSwap              uint64        // (37) Cumulative nswap for child processes (not maintained).
+	ExitSignal          int32         // (38) Signal to be sent to parent when we die. (since Linux 2.1.22)
+	Processor           int32         // (39) CPU number last executed on. (since Linux 2.2.8)
+	RtPriority          uint32        // (40) Real-time scheduling priority
+	Policy              uint32        // (41) Scheduling policy (see sched_setscheduler(2)).
+	DelayacctBlkioTicks uint64        // (42) Aggregated block I/O delays, measured in clock ticks (centiseconds).
+	GuestTime           uint64        // (43)  Guest time of the process
+	CGuestTime          int64         // (44) Guest time of the process's children
+	StartData           uint64        // (45) Address above which program initialized and uninitialized (BSS) data are placed.
+	EndData             uint64        // (46) Address below which program initialized and uninitialized (BSS) data are placed.
+	StartBrk            uint64        // (47) Address above which program heap can be expanded with brk(2).
+	ArgStart            uint64        // (48) Address below program command-line arguments (argv) are placed.
+	ArgEnd              uint64        // (49) Address above which program environment is placed.
+	EnvStart            uint64        // (50) Address below which program environment is placed.
+	EnvEnd              uint64        // (51) Address below which program environment is placed.
+	ExitCode            int32         // (52) The thread's exit status in the form reported by waitpid(2).
+}
+
+// fieldParseMap dispatches each whitespace-delimited token (by its 1-based
+// position) straight into the matching StatField member, parsing the raw
+// bytes in place rather than allocating an intermediate string per field.
+var fieldParseMap = map[int]func(data []byte, sf *StatField) (err error){
+	// pid
+	1: func(data []byte, sf *StatField) (err error) {
+		sf.Pid, err = parseInt32(data)
+		return
+	},
+
+	// state
+	3: func(data []byte, sf *StatField) (err error) {
+		sf.State = StatTaskState(data[0])
+		return nil
+	},
+
+	// ppid
+	4: func(data []byte, sf *StatField) (err error) {
+		sf.PPid, err = parseInt32(data)
+		return
+	},
+
+	// pgrp
+	5: func(data []byte, sf *StatField) (err error) {
+		sf.PGrp, err = parseInt32(data)
+		return
+	},
+
+	// session
+	6: func(data []byte, sf *StatField) (err error) {
+		sf.Session, err = parseInt32(data)
+		return
+	},
+
+	// ttynr
+	7: func(data []byte, sf *StatField) (err error) {
+		sf.TTYNR, err = parseInt32(data)
+		return
+	},
+
+	// tpgid
+	8: func(data []byte, sf *StatField) (err error) {
+		sf.TPGid, err = parseInt32(data)
+		return
+	},
+
+	// taskflags
+	9: func(data []byte, sf *StatField) (err error) {
+		sf.TaskFlags, err = parseUint32(data)
+		return
+	},
+
+	// minflt
+	10: func(data []byte, sf *StatField) (err error) {
+		sf.MinFlt, err = parseUint64(data)
+		return
+	},
+
+	// cminflt
+	11: func(data []byte, sf *StatField) (err error) {
+		sf.CMinFlt, err = parseUint64(data)
+		return
+	},
+
+	// majflt
+	12: func(data []byte, sf *StatField) (err error) {
+		sf.MajFlt, err = parseUint64(data)
+		return
+	},
+
+	// cmajflt
+	13: func(data []byte, sf *StatField) (err error) {
+		sf.CMajFlt, err = parseUint64(data)
+		return
+	},
+
+	// utime
+	14: func(data []byte, sf *StatField) (err error) {
+		sf.UTime, err = parseUint64(data)
+		return
+	},
+
+	// stime
+	15: func(data []byte, sf *StatField) (err error) {
+		sf.STime, err = parseUint64(data)
+		return
+	},
+
+	// cutime
+	16: func(data []byte, sf *StatField) (err error) {
+		sf.CUTime, err = parseInt64(data)
+		return
+	},
+
+	// cstime
+	17: func(data []byte, sf *StatField) (err error) {
+		sf.CSTime, err = parseInt64(data)
+		return
+	},
+
+	// priority
+	18: func(data []byte, sf *StatField) (err error) {
+		sf.Priority, err = parseInt64(data)
+		return
+	},
+
+	// nice
+	19: func(data []byte, sf *StatField) (err error) {
+		sf.Nice, err = parseInt64(data)
+		return
+	},
+
+	// numthreads
+	20: func(data []byte, sf *StatField) (err error) {
+		sf.NumThreads, err = parseInt64(data)
+		return
+	},
+
+	// itrealvalue
+	21: func(data []byte, sf *StatField) (err error) {
+		sf.ItRealValue, err = parseInt64(data)
+		return
+	},
+
+	// starttime
+	22: func(data []byte, sf *StatField) (err error) {
+		sf.StartTime, err = parseUint64(data)
+		return
+	},
+
+	// vsize
+	23: func(data []byte, sf *StatField) (err error) {
+		sf.VSize, err = parseUint64(data)
+		return
+	},
+
+	// rss
+	24: func(data []byte, sf *StatField) (err error) {
+		sf.RSS, err = parseUint64(data)
+		return
+	},
+
+	// rsslim
+	25: func(data []byte, sf *StatField) (err error) {
+		sf.RSSLim, err = parseUint64(data)
+		return
+	},
+
+	// startcode
+	26: func(data []byte, sf *StatField) (err error) {
+		sf.StartCode, err = parseUint64(data)
+		return
+	},
+
+	// endcode
+	27: func(data []byte, sf *StatField) (err error) {
+		sf.EndCode, err = parseUint64(data)
+		return
+	},
+
+	// startstack
+	28: func(data []byte, sf *StatField) (err error) {
+		sf.StartStack, err = parseUint64(data)
+		return
+	},
+
+	// kstkesp
+	29: func(data []byte, sf *StatField) (err error) {
+		sf.KStkEsp, err = parseUint64(data)
+		return
+	},
+
+	// kstkeip
+	30: func(data []byte, sf *StatField) (err error) {
+		sf.KStkEip, err = parseUint64(data)
+		return
+	},
+
+	// taskpendingsig
+	31: func(data []byte, sf *StatField) (err error) {
+		sf.TaskPendingSig, err = parseUint64(data)
+		return
+	},
+
+	// taskblocksig
+	32: func(data []byte, sf *StatField) (err error) {
+		sf.TaskBlockSig, err = parseUint64(data)
+		return
+	},
+
+	// sigignoresig
+	33: func(data []byte, sf *StatField) (err error) {
+		sf.SigIgnoreSig, err = parseUint64(data)
+		return
+	},
+
+	// sigcatchsig
+	34: func(data []byte, sf *StatField) (err error) {
+		sf.SigCatchSig, err = parseUint64(data)
+		return
+	},
+
+	// wchan
+	35: func(data []byte, sf *StatField) (err error) {
+		sf.WChan, err = parseUint64(data)
+		return
+	},
+
+	// nswap
+	36: func(data []byte, sf *StatField) (err error) {
+		sf.NSwap, err = parseUint64(data)
+		return
+	},
+
+	// cnswap
+	37: func(data []byte, sf *StatField) (err error) {
+		sf.CNSwap, err = parseUint64(data)
+		return
+	},
+
+	// exitsignal
+	38: func(data []byte, sf *StatField) (err error) {
+		sf.ExitSignal, err = parseInt32(data)
+		return
+	},
+
+	// processor
+	39: func(data []byte, sf *StatField) (err error) {
+		sf.Processor, err = parseInt32(data)
+		return
+	},
+
+	// rtpriority
+	40: func(data []byte, sf *StatField) (err error) {
+		sf.RtPriority, err = parseUint32(data)
+		return
+	},
+
+	// policy
+	41: func(data []byte, sf *StatField) (err error) {
+		sf.Policy, err = parseUint32(data)
+		return
+	},
+
+	// delayacctblkioticks
+	42: func(data []byte, sf *StatField) (err error) {
+		sf.DelayacctBlkioTicks, err = parseUint64(data)
+		return
+	},
+
+	// guesttime
+	43: func(data []byte, sf *StatField) (err error) {
+		sf.GuestTime, err = parseUint64(data)
+		return
+	},
+
+	// cguesttime
+	44: func(data []byte, sf *StatField) (err error) {
+		sf.CGuestTime, err = parseInt64(data)
+		return
+	},
+
+	// startdata
+	45: func(data []byte, sf *StatField) (err error) {
+		sf.StartData, err = parseUint64(data)
+		return
+	},
+
+	// enddata
+	46: func(data []byte, sf *StatField) (err error) {
+		sf.EndData, err = parseUint64(data)
+		return
+	},
+
+	// startbrk
+	47: func(data []byte, sf *StatField) (err error) {
+		sf.StartBrk, err = parseUint64(data)
+		return
+	},
+
+	// argstart
+	48: func(data []byte, sf *StatField) (err error) {
+		sf.ArgStart, err = parseUint64(data)
+		return
+	},
+
+	// argend
+	49: func(data []byte, sf *StatField) (err error) {
+		sf.ArgEnd, err = parseUint64(data)
+		return
+	},
+
+	// envstart
+	50: func(data []byte, sf *StatField) (err error) {
+		sf.EnvStart, err = parseUint64(data)
+		return
+	},
+
+	// envend
+	51: func(data []byte, sf *StatField) (err error) {
+		sf.EnvEnd, err = parseUint64(data)
+		return
+	},
+
+	// exitcode
+	52: func(data []byte, sf *StatField) (err error) {
+		sf.ExitCode, err = parseInt32(data)
+		return
+	},
+}
+
+var errInvalidDigit = errors.New("proc: invalid digit in stat field")
+
+// parseUint64 parses an unsigned decimal integer directly from its ASCII
+// bytes, without the intermediate string allocation strconv.ParseUint
+// requires.
+func parseUint64(b []byte) (uint64, error) {
+	if len(b) == 0 {
+		return 0, errInvalidDigit
+	}
+
+	var n uint64
+	for _, c := range b {
+		if c < '0' || c > '9' {
+			return 0, errInvalidDigit
+		}
+		n = n*10 + uint64(c-'0')
+	}
+
+	return n, nil
+}
+
+// parseInt64 parses a (possibly negative) decimal integer directly from its
+// ASCII bytes.
+func parseInt64(b []byte) (int64, error) {
+	if len(b) > 0 && b[0] == '-' {
+		n, err := parseUint64(b[1:])
+		if err != nil {
+			return 0, err
+		}
+		return -int64(n), nil
+	}
+
+	n, err := parseUint64(b)
+	if err != nil {
+		return 0, err
+	}
+	return int64(n), nil
+}
+
+func parseUint32(b []byte) (uint32, error) {
+	n, err := parseUint64(b)
+	if err != nil {
+		return 0, err
+	}
+	return uint32(n), nil
+}
+
+func parseInt32(b []byte) (int32, error) {
+	n, err := parseInt64(b)
+	if err != nil {
+		return 0, err
+	}
+	return int32(n), nil
+}
+
+var erreof = errors.New("stat stream read end of file")
+
+// StatStream scans whitespace-delimited tokens out of a byte slice in
+// place; it never copies or allocates beyond returning the token's bounds.
+type StatStream struct {
+	data []byte
+	cur  int
+}
+
+func (ss *StatStream) isEOF() bool {
+	return ss.cur >= len(ss.data) || ss.data[ss.cur] == '\n'
+}
+
+func (ss *StatStream) isSpace() bool {
+	return ss.cur < len(ss.data) && ss.data[ss.cur] == ' '
+}
+
+// next returns the bounds of the next token as a sub-slice of ss.data, with
+// no copy.
+func (ss *StatStream) next() ([]byte, error) {
+	start := ss.cur
+	for {
+		if ss.isEOF() {
+			if ss.cur == start {
+				return nil, erreof
+			}
+			return ss.data[start:ss.cur], erreof
+		}
+
+		if ss.isSpace() {
+			tok := ss.data[start:ss.cur]
+			ss.cur++
+			return tok, nil
+		}
+
+		ss.cur++
+	}
+}
+
+// Stat reads and parses a single /proc/[pid]/stat file. A Stat value is
+// reusable across repeated Parse/ParseInto calls: its scratch buffer is
+// refilled, not reallocated, so polling many PIDs at high frequency costs
+// one allocation per snapshot at most (for growing the buffer on first use
+// or when comm unexpectedly widens it).
+type Stat struct {
+	pf     string
+	buf    []byte
+	fields StatField
+}
+
+func NewStat(proc string) *Stat {
+	return &Stat{
+		pf:  proc,
+		buf: make([]byte, 512),
+	}
+}
+
+// Parse reads and parses the stat file, returning a pointer to the Stat's
+// internal StatField. The returned pointer is reused on the next call;
+// callers that need to retain a snapshot across calls should copy it.
+func (s *Stat) Parse() (*StatField, error) {
+	if err := s.ParseInto(&s.fields); err != nil {
+		return nil, err
+	}
+	return &s.fields, nil
+}
+
+// ParseInto reads the stat file and fills sf in place, without allocating a
+// StatField of its own.
+func (s *Stat) ParseInto(sf *StatField) error {
+	n, err := s.read()
+	if err != nil {
+		return err
+	}
+
+	return parseStatBytes(s.buf[:n], sf)
+}
+
+// read fills s.buf from the stat file, growing it if the file doesn't fit,
+// and returns the number of bytes read.
+func (s *Stat) read() (int, error) {
+	f, err := os.OpenFile(s.pf, os.O_RDONLY, 0)
+	if err != nil {
+		return 0, err
+	}
+	defer f.Close()
+
+	total := 0
+	for {
+		if total == len(s.buf) {
+			grown := make([]byte, len(s.buf)*2)
+			copy(grown, s.buf)
+			s.buf = grown
+		}
+
+		n, err := f.Read(s.buf[total:])
+		total += n
+		if err != nil {
+			if err == io.EOF {
+				break
+			}
+			return 0, err
+		}
+		if n == 0 {
+			break
+		}
+	}
+
+	return total, nil
+}
+
+// parseStatBytes fills sf from the raw contents of a /proc/[pid]/stat file.
+// The comm field (2) is handled specially: it is the only field that may
+// itself contain spaces or parentheses, so it is located by its own
+// parentheses rather than by whitespace splitting, per the advice in
+// proc(5). The kernel always prints it with a matching pair, so scanning
+// for the *last* ')' in the line correctly recovers names like "a) (b)".
+func parseStatBytes(data []byte, sf *StatField) error {
+	openIdx := -1
+	for i, c := range data {
+		if c == '(' {
+			openIdx = i
+			break
+		}
+	}
+	closeIdx := -1
+	for i := len(data) - 1; i > openIdx; i-- {
+		if data[i] == ')' {
+			closeIdx = i
+			break
+		}
+	}
+	if openIdx < 0 || closeIdx < 0 {
+		return errors.New("proc: malformed stat line: comm field not found")
+	}
+
+	if err := fieldParseMap[1](data[:openIdx-1], sf); err != nil {
+		return err
+	}
+	sf.Comm = string(data[openIdx+1 : closeIdx])
+
+	stream := StatStream{data: data, cur: closeIdx + 2}
+	for progress := 3; progress <= 52; progress++ {
+		tok, err := stream.next()
+		if len(tok) > 0 {
+			if parseFunc, ok := fieldParseMap[progress]; ok {
+				if ferr := parseFunc(tok, sf); ferr != nil {
+					return ferr
+				}
+			}
+		}
+		if err == erreof {
+			break
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package proc
+
+import (
+	"testing"
+)
+
+func TestStatParse(t *testing.T) {
+	s := NewStat("/proc/self/stat")
+	fields, err := s.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if fields.Pid == 0 {
+		t.Errorf("Pid = 0, want the test process's own pid")
+	}
+	if fields.Comm == "" {
+		t.Error("Comm is empty, want the test binary's command name")
+	}
+}
+
+// BenchmarkStatParseInto exercises the hot path of repeatedly polling the
+// same process: reuse one Stat and one StatField across every iteration so
+// -benchmem reports the steady-state allocations per snapshot.
+func BenchmarkStatParseInto(b *testing.B) {
+	s := NewStat("/proc/self/stat")
+	sf := new(StatField)
+
+	b.ReportAllocs()
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		if err := s.ParseInto(sf); err != nil {
+			b.Fatal(err)
+		}
+	}
+}
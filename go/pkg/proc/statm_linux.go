@@ -0,0 +1,58 @@
+package proc
+
+import (
+	"io/ioutil"
+	"strconv"
+	"strings"
+)
+
+// StatMField is the parsed form of /proc/[pid]/statm, whose seven
+// space-separated columns report memory usage in pages.
+// define by http://man7.org/linux/man-pages/man5/proc.5.html
+type StatMField struct {
+	Size     uint64 // total program size
+	Resident uint64 // resident set size
+	Shared   uint64 // number of resident shared pages
+	Text     uint64 // text (code)
+	Lib      uint64 // library (unused since Linux 2.6; always 0)
+	Data     uint64 // data + stack
+	Dt       uint64 // dirty pages (unused since Linux 2.6; always 0)
+}
+
+type StatM struct {
+	pf string
+}
+
+// NewStatM returns a StatM reading the given /proc/[pid]/statm path.
+func NewStatM(path string) *StatM {
+	return &StatM{pf: path}
+}
+
+// Parse reads and parses the statm file into a StatMField.
+func (s *StatM) Parse() (*StatMField, error) {
+	data, err := ioutil.ReadFile(s.pf)
+	if err != nil {
+		return nil, err
+	}
+
+	fields := strings.Fields(strings.TrimSpace(string(data)))
+	dst := make([]*uint64, 7)
+	field := new(StatMField)
+	dst[0] = &field.Size
+	dst[1] = &field.Resident
+	dst[2] = &field.Shared
+	dst[3] = &field.Text
+	dst[4] = &field.Lib
+	dst[5] = &field.Data
+	dst[6] = &field.Dt
+
+	for i := 0; i < len(fields) && i < len(dst); i++ {
+		n, err := strconv.ParseUint(fields[i], 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		*dst[i] = n
+	}
+
+	return field, nil
+}
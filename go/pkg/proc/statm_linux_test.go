@@ -0,0 +1,24 @@
+package proc
+
+import "testing"
+
+func TestStatMParse(t *testing.T) {
+	s := NewStatM("testdata/statm")
+	field, err := s.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := StatMField{
+		Size:     31255,
+		Resident: 1250,
+		Shared:   550,
+		Text:     225,
+		Lib:      0,
+		Data:     950,
+		Dt:       0,
+	}
+	if *field != want {
+		t.Errorf("Parse() = %+v, want %+v", *field, want)
+	}
+}
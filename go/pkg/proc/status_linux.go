@@ -0,0 +1,132 @@
+package proc
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// StatusField is the parsed form of /proc/[pid]/status, which duplicates
+// and extends a subset of the information found in stat(5) in a more
+// human-readable, whitespace-padded key/value format.
+// define by http://man7.org/linux/man-pages/man5/proc.5.html
+type StatusField struct {
+	Name                     string   // Command run by this process.
+	State                    string   // Current state of the process.
+	Uid                      [4]int32 // Real, effective, saved set, and filesystem UIDs.
+	Gid                      [4]int32 // Real, effective, saved set, and filesystem GIDs.
+	VmPeak                   uint64   // Peak virtual memory size, in kB.
+	VmSize                   uint64   // Virtual memory size, in kB.
+	VmRSS                    uint64   // Resident set size, in kB.
+	VmSwap                   uint64   // Swapped-out virtual memory size, in kB.
+	Threads                  int64    // Number of threads in process containing this thread.
+	VoluntaryCtxtSwitches    uint64   // Number of voluntary context switches.
+	NonvoluntaryCtxtSwitches uint64   // Number of involuntary context switches.
+}
+
+type Status struct {
+	pf string
+}
+
+// NewStatus returns a Status reading the given /proc/[pid]/status path.
+func NewStatus(path string) *Status {
+	return &Status{pf: path}
+}
+
+// Parse reads and parses the status file into a StatusField.
+func (s *Status) Parse() (*StatusField, error) {
+	f, err := os.Open(s.pf)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	field := new(StatusField)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		line := scanner.Text()
+		sepIdx := strings.IndexByte(line, ':')
+		if sepIdx < 0 {
+			continue
+		}
+
+		key := line[:sepIdx]
+		value := strings.TrimSpace(line[sepIdx+1:])
+
+		if err := field.fill(key, value); err != nil {
+			return nil, err
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return field, nil
+}
+
+func (sf *StatusField) fill(key, value string) error {
+	switch key {
+	case "Name":
+		sf.Name = value
+	case "State":
+		sf.State = value
+	case "Uid":
+		return fillIDQuad(value, &sf.Uid)
+	case "Gid":
+		return fillIDQuad(value, &sf.Gid)
+	case "VmPeak":
+		return fillKBField(value, &sf.VmPeak)
+	case "VmSize":
+		return fillKBField(value, &sf.VmSize)
+	case "VmRSS":
+		return fillKBField(value, &sf.VmRSS)
+	case "VmSwap":
+		return fillKBField(value, &sf.VmSwap)
+	case "Threads":
+		n, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		sf.Threads = n
+	case "voluntary_ctxt_switches":
+		n, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		sf.VoluntaryCtxtSwitches = n
+	case "nonvoluntary_ctxt_switches":
+		n, err := strconv.ParseUint(value, 10, 64)
+		if err != nil {
+			return err
+		}
+		sf.NonvoluntaryCtxtSwitches = n
+	}
+
+	return nil
+}
+
+// fillKBField parses a "<n> kB" value, as used by the Vm* fields.
+func fillKBField(value string, dst *uint64) error {
+	value = strings.TrimSuffix(strings.TrimSpace(value), " kB")
+	n, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return err
+	}
+	*dst = n
+	return nil
+}
+
+// fillIDQuad parses the tab-separated real/effective/saved-set/filesystem
+// quad shared by the Uid and Gid lines.
+func fillIDQuad(value string, dst *[4]int32) error {
+	parts := strings.Fields(value)
+	for i := 0; i < len(parts) && i < 4; i++ {
+		n, err := strconv.ParseInt(parts[i], 10, 32)
+		if err != nil {
+			return err
+		}
+		dst[i] = int32(n)
+	}
+	return nil
+}
@@ -0,0 +1,33 @@
+package proc
+
+import "testing"
+
+func TestStatusParse(t *testing.T) {
+	s := NewStatus("testdata/status")
+	field, err := s.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if field.Name != "bash" {
+		t.Errorf("Name = %q, want %q", field.Name, "bash")
+	}
+	if field.VmRSS != 5000 {
+		t.Errorf("VmRSS = %d, want 5000", field.VmRSS)
+	}
+	if field.VmPeak != 131072 {
+		t.Errorf("VmPeak = %d, want 131072", field.VmPeak)
+	}
+	if field.Threads != 1 {
+		t.Errorf("Threads = %d, want 1", field.Threads)
+	}
+	if field.Uid != [4]int32{1000, 1000, 1000, 1000} {
+		t.Errorf("Uid = %v, want [1000 1000 1000 1000]", field.Uid)
+	}
+	if field.VoluntaryCtxtSwitches != 42 {
+		t.Errorf("VoluntaryCtxtSwitches = %d, want 42", field.VoluntaryCtxtSwitches)
+	}
+	if field.NonvoluntaryCtxtSwitches != 7 {
+		t.Errorf("NonvoluntaryCtxtSwitches = %d, want 7", field.NonvoluntaryCtxtSwitches)
+	}
+}
@@ -0,0 +1,133 @@
+package proc
+
+import (
+	"bufio"
+	"os"
+	"strconv"
+	"strings"
+)
+
+// CPUTimes holds one line of /proc/stat's per-CPU (or aggregate) jiffy
+// counters, in the kernel's own column order.
+// define by http://man7.org/linux/man-pages/man5/proc.5.html
+type CPUTimes struct {
+	User      uint64
+	Nice      uint64
+	System    uint64
+	Idle      uint64
+	IOWait    uint64
+	IRQ       uint64
+	SoftIRQ   uint64
+	Steal     uint64
+	Guest     uint64
+	GuestNice uint64
+}
+
+// SystemStatField is the parsed form of /proc/stat.
+type SystemStatField struct {
+	CPUTotal  CPUTimes   // the aggregate "cpu" line
+	CPUs      []CPUTimes // per-CPU "cpuN" lines, indexed by N
+	Ctxt      uint64     // total context switches across all CPUs since boot
+	BTime     int64      // boot time, in seconds since the Unix epoch
+	Processes uint64     // number of forks since boot
+}
+
+type SystemStat struct {
+	pf string
+}
+
+// NewSystemStat returns a SystemStat reading the given /proc/stat path.
+func NewSystemStat(path string) *SystemStat {
+	return &SystemStat{pf: path}
+}
+
+// Parse reads and parses /proc/stat.
+func (s *SystemStat) Parse() (*SystemStatField, error) {
+	f, err := os.Open(s.pf)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	field := new(SystemStatField)
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		fields := strings.Fields(scanner.Text())
+		if len(fields) == 0 {
+			continue
+		}
+
+		switch {
+		case fields[0] == "cpu":
+			cpu, err := parseCPUTimes(fields[1:])
+			if err != nil {
+				return nil, err
+			}
+			field.CPUTotal = cpu
+
+		case strings.HasPrefix(fields[0], "cpu"):
+			idx, err := strconv.Atoi(fields[0][len("cpu"):])
+			if err != nil {
+				return nil, err
+			}
+			cpu, err := parseCPUTimes(fields[1:])
+			if err != nil {
+				return nil, err
+			}
+			for idx >= len(field.CPUs) {
+				field.CPUs = append(field.CPUs, CPUTimes{})
+			}
+			field.CPUs[idx] = cpu
+
+		case fields[0] == "ctxt":
+			field.Ctxt, err = strconv.ParseUint(fields[1], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+
+		case fields[0] == "btime":
+			field.BTime, err = strconv.ParseInt(fields[1], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+
+		case fields[0] == "processes":
+			field.Processes, err = strconv.ParseUint(fields[1], 10, 64)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return nil, err
+	}
+
+	return field, nil
+}
+
+// parseCPUTimes parses the space-separated jiffy columns following a
+// "cpu"/"cpuN" label. Older kernels report fewer columns (guest/guest_nice
+// were added later); missing trailing columns are left zero.
+func parseCPUTimes(cols []string) (CPUTimes, error) {
+	values := make([]uint64, 10)
+	for i := 0; i < len(cols) && i < len(values); i++ {
+		n, err := strconv.ParseUint(cols[i], 10, 64)
+		if err != nil {
+			return CPUTimes{}, err
+		}
+		values[i] = n
+	}
+
+	return CPUTimes{
+		User:      values[0],
+		Nice:      values[1],
+		System:    values[2],
+		Idle:      values[3],
+		IOWait:    values[4],
+		IRQ:       values[5],
+		SoftIRQ:   values[6],
+		Steal:     values[7],
+		Guest:     values[8],
+		GuestNice: values[9],
+	}, nil
+}
@@ -0,0 +1,33 @@
+package proc
+
+import "testing"
+
+func TestSystemStatParse(t *testing.T) {
+	s := NewSystemStat("testdata/stat")
+	field, err := s.Parse()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := CPUTimes{User: 201871, Nice: 0, System: 274015, Idle: 2149715, IOWait: 4647, SoftIRQ: 2625}
+	if field.CPUTotal != want {
+		t.Errorf("CPUTotal = %+v, want %+v", field.CPUTotal, want)
+	}
+
+	if len(field.CPUs) != 2 {
+		t.Fatalf("len(CPUs) = %d, want 2", len(field.CPUs))
+	}
+	if field.CPUs[0].User != 50000 || field.CPUs[1].IOWait != 1200 {
+		t.Errorf("CPUs = %+v", field.CPUs)
+	}
+
+	if field.Ctxt != 9876543 {
+		t.Errorf("Ctxt = %d, want 9876543", field.Ctxt)
+	}
+	if field.BTime != 1690000000 {
+		t.Errorf("BTime = %d, want 1690000000", field.BTime)
+	}
+	if field.Processes != 54321 {
+		t.Errorf("Processes = %d, want 54321", field.Processes)
+	}
+}
@@ -0,0 +1,75 @@
+package proc
+
+// ProcessTree is an in-memory forest of every process visible via ForEach
+// at the moment Tree was called, linked up using each process's PPid.
+type ProcessTree struct {
+	byPID    map[int32]*StatField
+	children map[int32][]int32
+	roots    []int32
+}
+
+// Tree snapshots every live process via ForEach and builds a ProcessTree
+// from their PPid links.
+func Tree() (*ProcessTree, error) {
+	t := &ProcessTree{
+		byPID:    make(map[int32]*StatField),
+		children: make(map[int32][]int32),
+	}
+
+	if err := ForEach(func(sf *StatField) error {
+		cp := *sf
+		t.byPID[cp.Pid] = &cp
+		return nil
+	}); err != nil {
+		return nil, err
+	}
+
+	for pid, sf := range t.byPID {
+		if _, ok := t.byPID[sf.PPid]; ok && sf.PPid != pid {
+			t.children[sf.PPid] = append(t.children[sf.PPid], pid)
+		} else {
+			t.roots = append(t.roots, pid)
+		}
+	}
+
+	return t, nil
+}
+
+// Walk performs a depth-first, pstree-style traversal starting from each
+// root (a process whose parent wasn't itself visible in the snapshot),
+// calling fn with the current depth and that process's stat fields.
+func (t *ProcessTree) Walk(fn func(depth int, sf *StatField)) {
+	for _, root := range t.roots {
+		t.walk(root, 0, fn)
+	}
+}
+
+func (t *ProcessTree) walk(pid int32, depth int, fn func(depth int, sf *StatField)) {
+	sf, ok := t.byPID[pid]
+	if !ok {
+		return
+	}
+
+	fn(depth, sf)
+	for _, child := range t.children[pid] {
+		t.walk(child, depth+1, fn)
+	}
+}
+
+// Descendants returns every pid transitively reachable from pid's
+// children, for supervisor-style bulk signaling (e.g. signal pid and every
+// process it spawned).
+func (t *ProcessTree) Descendants(pid int32) []int32 {
+	var out []int32
+
+	var collect func(int32)
+	collect = func(p int32) {
+		for _, child := range t.children[p] {
+			out = append(out, child)
+			collect(child)
+		}
+	}
+	collect(pid)
+
+	return out
+}